@@ -0,0 +1,273 @@
+// Command scaffold interactively materializes the go.mod template in
+// ../.. into a working project: it prompts for a web framework, a DB
+// driver, a config loader, a logger, and optional packs, then writes a
+// real go.mod, main.go, env files, and a starter internal/ layout into
+// the current directory, and stages the result with git.
+//
+// Subcommands:
+//
+//	scaffold new            interactive generator (default when no args given)
+//	scaffold private-deps   rewrite an existing go.mod with replace lines
+//	                        for internal module prefixes and print an
+//	                        install script for the matching git config
+//	scaffold refresh        re-pin every candidate dependency in the
+//	                        template to its latest version and record it
+//	                        in TEMPLATE_VERSIONS.json
+//	scaffold diff           compare the template's pinned versions
+//	                        against TEMPLATE_VERSIONS.json and report
+//	                        which candidates moved major/minor/patch
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/generate"
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/lockfile"
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/options"
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/privatemod"
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/prompt"
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/proxy"
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/semver"
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/templatefile"
+)
+
+// thisFile is this source file's own path, resolved at compile time, so
+// templateGoModPath below can find templates/go.mod regardless of the cwd
+// "scaffold new" is run from (which is the new project's directory, not
+// templates/ — unlike "refresh"/"diff", which are meant to be run from
+// templates/ itself; see defaultTemplatePath).
+var _, thisFile, _, _ = runtime.Caller(0)
+
+// templateGoModPath is templates/go.mod's path, found relative to this
+// source file (cmd/scaffold/main.go) rather than the process's cwd.
+var templateGoModPath = filepath.Join(filepath.Dir(thisFile), "..", "..", "..", "go.mod")
+
+func main() {
+	cmd := "new"
+	args := os.Args[1:]
+	if len(args) > 0 {
+		cmd = args[0]
+		args = args[1:]
+	}
+
+	var err error
+	switch cmd {
+	case "new":
+		err = runNew()
+	case "private-deps":
+		err = runPrivateDeps(args)
+	case "refresh":
+		err = runRefresh(args)
+	case "diff":
+		err = runDiff(args)
+	default:
+		err = fmt.Errorf("unknown subcommand %q (want \"new\", \"private-deps\", \"refresh\", or \"diff\")", cmd)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "scaffold:", err)
+		os.Exit(1)
+	}
+}
+
+func runNew() error {
+	p := prompt.New(os.Stdin, os.Stdout)
+
+	modulePath, err := p.Ask("Module path", "github.com/yourorg/yourproject")
+	if err != nil {
+		return err
+	}
+
+	sel := options.Selections{
+		ModulePath: modulePath,
+		Choices:    map[string]options.Option{},
+	}
+
+	for _, cat := range options.Menu {
+		names := make([]string, len(cat.Options))
+		for i, o := range cat.Options {
+			names[i] = o.Name
+		}
+		name, err := p.Choose(cat.Name, names, !cat.Required)
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			continue
+		}
+		opt, _ := cat.Find(name)
+		sel.Choices[cat.Name] = opt
+	}
+
+	if sel.Choices["Database"].Name == "gorm" {
+		names := make([]string, len(options.GormDrivers))
+		for i, o := range options.GormDrivers {
+			names[i] = o.Name
+		}
+		driverName, err := p.Choose("Gorm driver", names, false)
+		if err != nil {
+			return err
+		}
+		sel.GormDriverName = driverName
+	}
+
+	// Pick up whatever "scaffold refresh" last pinned in the template, so
+	// a refreshed version menu actually reaches generated projects
+	// instead of the version baked into options.Menu at compile time.
+	if versions, err := templatefile.VersionsByModule(templateGoModPath); err == nil {
+		for name, opt := range sel.Choices {
+			if v, ok := versions[opt.Import]; ok {
+				opt.Version = v
+				sel.Choices[name] = opt
+			}
+		}
+	}
+
+	privateAnswer, err := p.Ask("Use private module preset? (y/N)", "n")
+	if err != nil {
+		return err
+	}
+	if privateAnswer == "y" || privateAnswer == "yes" {
+		sel.Private = true
+
+		prefixes, err := p.Ask("Internal module prefixes (comma-separated)", "")
+		if err != nil {
+			return err
+		}
+		sel.PrivatePrefixes = splitNonEmpty(prefixes)
+
+		siblings, err := p.Ask("Sibling module directories under ../ (comma-separated)", "")
+		if err != nil {
+			return err
+		}
+		sel.Siblings = splitNonEmpty(siblings)
+	}
+
+	ciAnswer, err := p.Ask("Generate CI/CD artifacts (GitHub Actions, Dockerfile, goreleaser)? (y/N)", "n")
+	if err != nil {
+		return err
+	}
+	sel.CI = ciAnswer == "y" || ciAnswer == "yes"
+
+	bazelAnswer, err := p.Ask("Generate Bazel BUILD.bazel files and go_repositories.bzl? (y/N)", "n")
+	if err != nil {
+		return err
+	}
+	sel.Bazel = bazelAnswer == "y" || bazelAnswer == "yes"
+
+	dir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if abs, err := filepath.Abs(dir); err == nil && abs == filepath.Dir(templateGoModPath) {
+		return fmt.Errorf("scaffold new: refusing to generate into %s, the scaffold tool's own template directory", dir)
+	}
+	if err := generate.Project(dir, sel); err != nil {
+		return err
+	}
+	if err := generate.Stage(dir); err != nil {
+		return err
+	}
+
+	fmt.Println("scaffold: project written and staged in", dir)
+	return nil
+}
+
+func runPrivateDeps(args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("usage: scaffold private-deps <go.mod path> <prefix> [<prefix>...]")
+	}
+	modPath, prefixes := args[0], args[1:]
+
+	if err := privatemod.AddReplaces(modPath, prefixes); err != nil {
+		return err
+	}
+	fmt.Print(privatemod.InstallScript(prefixes))
+	return nil
+}
+
+// defaultTemplatePath is templates/go.mod itself, relative to the
+// directory this command is expected to be run from: templates/ (e.g.
+// `scaffold refresh`, with the binary installed from ./scaffold).
+const defaultTemplatePath = "go.mod"
+
+func runRefresh(args []string) error {
+	templatePath := defaultTemplatePath
+	if len(args) > 0 {
+		templatePath = args[0]
+	}
+	lockPath := filepath.Join(filepath.Dir(templatePath), "TEMPLATE_VERSIONS.json")
+
+	tmpl, err := templatefile.Parse(templatePath)
+	if err != nil {
+		return err
+	}
+
+	client, err := proxy.NewClient()
+	if err != nil {
+		return err
+	}
+	lock := lockfile.Lockfile{}
+	for _, dep := range tmpl.Dependencies() {
+		latest, err := client.Latest(dep.Module)
+		if err != nil {
+			return err
+		}
+		sum, err := client.Sum(dep.Module, latest)
+		if err != nil {
+			return err
+		}
+		tmpl.SetVersion(dep.Module, latest)
+		lock[dep.Module] = lockfile.Entry{Version: latest, Checksum: sum}
+		fmt.Printf("scaffold: %s -> %s\n", dep.Module, latest)
+	}
+
+	if err := tmpl.Write(templatePath); err != nil {
+		return err
+	}
+	return lockfile.Save(lockPath, lock)
+}
+
+func runDiff(args []string) error {
+	templatePath := defaultTemplatePath
+	if len(args) > 0 {
+		templatePath = args[0]
+	}
+	lockPath := filepath.Join(filepath.Dir(templatePath), "TEMPLATE_VERSIONS.json")
+
+	tmpl, err := templatefile.Parse(templatePath)
+	if err != nil {
+		return err
+	}
+	lock, err := lockfile.Load(lockPath)
+	if err != nil {
+		return err
+	}
+
+	for _, dep := range tmpl.Dependencies() {
+		entry, ok := lock[dep.Module]
+		if !ok {
+			fmt.Printf("%s: %s (no lockfile entry, run `scaffold refresh`)\n", dep.Module, dep.Version)
+			continue
+		}
+		bump := semver.Compare(dep.Version, entry.Version)
+		if bump == semver.None {
+			continue
+		}
+		fmt.Printf("%s: %s -> %s (%s)\n", dep.Module, dep.Version, entry.Version, bump)
+	}
+	return nil
+}
+
+func splitNonEmpty(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
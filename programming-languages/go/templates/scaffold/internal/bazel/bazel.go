@@ -0,0 +1,207 @@
+// Package bazel renders the opt-in Bazel mode: BUILD.bazel files for the
+// generated cmd/ and internal/ packages, plus a go_repositories.bzl macro
+// listing the chosen dependencies, so the same project builds under both
+// `go build` and `bazel build`.
+package bazel
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/options"
+)
+
+// depLabel returns the external go_repository target a go_library/go_binary
+// rule must list in `deps` to import the Option named name — built from the
+// same bazelRepoName used to name the go_repository rule in GoRepositories,
+// so the two can't drift.
+//
+// zerolog's generated code imports the "github.com/rs/zerolog/log"
+// subpackage, not just the module root; gazelle would emit a dep on that
+// subpackage's own target, but this only tracks one label per module, so
+// the zerolog entry points at the root package and may need a manual
+// `//log` suffix added after a real gazelle run.
+func depLabel(name string) (string, bool) {
+	opt, ok := findOption(name)
+	if !ok || opt.Import == "" {
+		return "", false
+	}
+	return fmt.Sprintf("@%s//:%s", bazelRepoName(opt.Import), bazelTargetName(opt.Import)), true
+}
+
+// findOption looks up name across every category in options.Menu and the
+// gorm driver submenu, the same places BuildFiles and GoRepositories draw
+// selections from.
+func findOption(name string) (options.Option, bool) {
+	for _, cat := range options.Menu {
+		if opt, ok := cat.Find(name); ok {
+			return opt, true
+		}
+	}
+	return options.FindGormDriver(name)
+}
+
+func bazelDeps(names ...string) string {
+	var deps []string
+	for _, name := range names {
+		if label, ok := depLabel(name); ok {
+			deps = append(deps, fmt.Sprintf("%q", label))
+		}
+	}
+	if len(deps) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\n    deps = [\n        %s,\n    ],", strings.Join(deps, ",\n        "))
+}
+
+// BuildFiles returns one BUILD.bazel per generated package, keyed by its
+// directory relative to the project root, mirroring what a `gazelle`
+// run would produce for this fixed layout.
+func BuildFiles(sel options.Selections) map[string]string {
+	name := lastSegment(sel.ModulePath)
+	fw := sel.Choices["Web Framework"].Name
+	lg := sel.Choices["Logging"].Name
+	cfg := sel.Choices["Configuration"].Name
+
+	mainDeps := bazelDeps(fw, lg)
+	files := map[string]string{
+		"BUILD.bazel": fmt.Sprintf(`load("@io_bazel_rules_go//go:def.bzl", "go_binary", "go_library")
+
+go_library(
+    name = %q,
+    srcs = ["main.go"],
+    importpath = %q,%s
+    visibility = ["//visibility:private"],
+)
+
+go_binary(
+    name = "app",
+    embed = [":%s"],
+    visibility = ["//visibility:public"],
+)
+`, name, sel.ModulePath, mainDeps, name),
+	}
+
+	pkgDeps := map[string]string{
+		"config":  bazelDeps(cfg),
+		"logger":  bazelDeps(lg),
+		"handler": "",
+	}
+	for _, pkg := range []string{"config", "logger", "handler"} {
+		dir := "internal/" + pkg
+		files[dir+"/BUILD.bazel"] = fmt.Sprintf(`load("@io_bazel_rules_go//go:def.bzl", "go_library")
+
+go_library(
+    name = %q,
+    srcs = ["%s.go"],
+    importpath = "%s/%s",%s
+    visibility = ["//:__subpackages__"],
+)
+`, pkg, pkg, sel.ModulePath, dir, pkgDeps[pkg])
+	}
+
+	return files
+}
+
+// GoRepositories renders bazel/go_repositories.bzl: a go_repositories()
+// macro exposing go_repository rules for every chosen dependency, so a
+// downstream WORKSPACE can `load` and call it directly.
+//
+// Two-tier loading: a workspace may call go_repositories() standalone (the
+// versions below are the source of truth), or after its own go.mod-driven
+// version selection via gazelle's update-repos, in which case whichever
+// loads second wins and the two can silently diverge from what go.mod
+// declares. Keep this file and go.mod in sync by hand, or prefer
+// bazel-gazelle's `go_deps` extension (bzlmod) where one graph feeds both.
+func GoRepositories(sel options.Selections) string {
+	type dep struct {
+		importpath string
+		version    string
+	}
+	var deps []dep
+	for _, cat := range options.Menu {
+		opt, ok := sel.Choices[cat.Name]
+		if !ok || opt.Import == "" {
+			continue
+		}
+		deps = append(deps, dep{opt.Import, opt.Version})
+	}
+	if db, ok := sel.Choices["Database"]; ok && db.Name == "gorm" {
+		if driver, ok := options.FindGormDriver(sel.GormDriverName); ok {
+			deps = append(deps, dep{driver.Import, driver.Version})
+		}
+	}
+	sort.Slice(deps, func(i, j int) bool { return deps[i].importpath < deps[j].importpath })
+
+	var rules strings.Builder
+	for _, d := range deps {
+		fmt.Fprintf(&rules, `    go_repository(
+        name = %q,
+        importpath = %q,
+        version = %q,
+    )
+`, bazelRepoName(d.importpath), d.importpath, d.version)
+	}
+
+	return fmt.Sprintf(`# Divergence risk: these versions are pinned independently of go.mod's
+# require block. A downstream workspace calling go_repositories() after its
+# own version selection (e.g. via gazelle update-repos) may override these
+# with different versions; Bazel resolves the conflict by last-load-wins,
+# not by matching go.mod. Re-run "scaffold refresh" and re-generate this
+# file whenever go.mod's pins change.
+
+load("@bazel_gazelle//:deps.bzl", "go_repository")
+
+def go_repositories():
+    """Declares the go_repository rules for this project's dependencies."""
+%s
+`, rules.String())
+}
+
+// bazelRepoName derives the go_repository rule name gazelle would assign
+// to importpath: reverse the domain's dot-separated labels, then append
+// the remaining path segments, all joined and underscored. E.g.
+// "github.com/gin-gonic/gin" -> "com_github_gin_gonic_gin" and
+// "go.uber.org/zap" -> "org_uber_go_zap".
+func bazelRepoName(importpath string) string {
+	parts := strings.Split(importpath, "/")
+	domainParts := strings.Split(parts[0], ".")
+	segments := make([]string, 0, len(domainParts)+len(parts)-1)
+	for i := len(domainParts) - 1; i >= 0; i-- {
+		segments = append(segments, domainParts[i])
+	}
+	segments = append(segments, parts[1:]...)
+	return strings.NewReplacer("-", "_", ".", "_").Replace(strings.Join(segments, "_"))
+}
+
+// bazelTargetName derives the go_library target name within a
+// go_repository: the importpath's last segment, unless that segment is a
+// Go module major-version suffix (v2, v3, ...), in which case the
+// segment before it is used — the Go package name drops the version
+// suffix even though the import path and repo name keep it.
+func bazelTargetName(importpath string) string {
+	parts := strings.Split(importpath, "/")
+	last := parts[len(parts)-1]
+	if len(parts) > 1 && isMajorVersionSuffix(last) {
+		return parts[len(parts)-2]
+	}
+	return last
+}
+
+func isMajorVersionSuffix(s string) bool {
+	if len(s) < 2 || s[0] != 'v' {
+		return false
+	}
+	for _, r := range s[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func lastSegment(modulePath string) string {
+	parts := strings.Split(modulePath, "/")
+	return parts[len(parts)-1]
+}
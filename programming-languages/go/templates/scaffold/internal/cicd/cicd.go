@@ -0,0 +1,186 @@
+// Package cicd renders the CI-ready artifacts that accompany a generated
+// project: a GitHub Actions workflow, a Dockerfile, and a .goreleaser.yml.
+// The Dockerfile keys off the chosen framework (for the exposed port) and
+// the workflow off the chosen database (for the integration-test service
+// container).
+package cicd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/options"
+)
+
+// frameworkPort gives the same port for every framework today (see
+// generate.mainGo), but is looked up per framework rather than hardcoded
+// so a framework that needs a different default can change independently.
+// healthPath is shared by every framework.
+var frameworkPort = map[string]string{
+	"gin":   "8080",
+	"echo":  "8080",
+	"fiber": "8080",
+	"chi":   "8080",
+}
+
+const healthPath = "/healthz"
+
+// dbService maps a chosen database Option name to the docker-compose
+// service block used for integration tests in CI, indented to nest under
+// the `test` job's `services:` key (6 spaces for the service name, 8 for
+// its fields).
+var dbService = map[string]string{
+	"pgx": `      postgres:
+        image: postgres:16
+        env:
+          POSTGRES_PASSWORD: postgres
+        ports:
+          - "5432:5432"
+        options: >-
+          --health-cmd pg_isready
+          --health-interval 10s
+          --health-timeout 5s
+          --health-retries 5`,
+	"gorm": `      postgres:
+        image: postgres:16
+        env:
+          POSTGRES_PASSWORD: postgres
+        ports:
+          - "5432:5432"
+        options: >-
+          --health-cmd pg_isready
+          --health-interval 10s
+          --health-timeout 5s
+          --health-retries 5`,
+	"mysql": `      mysql:
+        image: mysql:8
+        env:
+          MYSQL_ROOT_PASSWORD: mysql
+          MYSQL_DATABASE: app
+        ports:
+          - "3306:3306"
+        options: >-
+          --health-cmd "mysqladmin ping"
+          --health-interval 10s
+          --health-timeout 5s
+          --health-retries 5`,
+}
+
+// Workflow renders the GitHub Actions CI pipeline: lint via golangci-lint,
+// `go test ./... -json` piped through tparse, build, and a docker image
+// push on main.
+func Workflow(sel options.Selections) string {
+	db := sel.Choices["Database"].Name
+	var services string
+	if svc, ok := dbService[db]; ok {
+		services = "\n    services:\n" + svc + "\n"
+	}
+
+	return fmt.Sprintf(`name: CI
+
+on:
+  push:
+    branches: [main]
+  pull_request:
+
+jobs:
+  lint:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+      - uses: actions/setup-go@v5
+        with:
+          go-version: "1.22"
+      - uses: golangci/golangci-lint-action@v4
+
+  test:
+    runs-on: ubuntu-latest%s
+    steps:
+      - uses: actions/checkout@v4
+      - uses: actions/setup-go@v5
+        with:
+          go-version: "1.22"
+      - run: go install github.com/mfridman/tparse@latest
+      - run: go test ./... -json | tparse -all
+
+  build:
+    runs-on: ubuntu-latest
+    needs: [lint, test]
+    steps:
+      - uses: actions/checkout@v4
+      - uses: actions/setup-go@v5
+        with:
+          go-version: "1.22"
+      - run: go build ./...
+
+  docker:
+    runs-on: ubuntu-latest
+    needs: [build]
+    if: github.ref == 'refs/heads/main'
+    steps:
+      - uses: actions/checkout@v4
+      - uses: docker/login-action@v3
+        with:
+          username: ${{ secrets.DOCKERHUB_USERNAME }}
+          password: ${{ secrets.DOCKERHUB_TOKEN }}
+      - uses: docker/build-push-action@v5
+        with:
+          push: true
+          tags: ${{ github.repository }}:latest
+`, services)
+}
+
+// Dockerfile renders a multi-stage Dockerfile targeting the same Go
+// version declared in go.mod, exposing the chosen framework's port and
+// wiring its health check endpoint into the container HEALTHCHECK.
+func Dockerfile(sel options.Selections) string {
+	fw := sel.Choices["Web Framework"].Name
+	port := frameworkPort[fw]
+	if port == "" {
+		port = "8080"
+	}
+
+	return fmt.Sprintf(`# syntax=docker/dockerfile:1
+FROM golang:1.22 AS build
+WORKDIR /src
+COPY go.mod go.sum* ./
+RUN go mod download
+COPY . .
+RUN CGO_ENABLED=0 go build -o /out/app .
+
+FROM gcr.io/distroless/static-debian12
+COPY --from=build /out/app /app
+EXPOSE %s
+HEALTHCHECK --interval=30s --timeout=3s CMD ["/app", "-healthcheck"]
+ENTRYPOINT ["/app"]
+`, port)
+}
+
+// Goreleaser renders a .goreleaser.yml building the project binary for the
+// usual release platforms.
+func Goreleaser(sel options.Selections) string {
+	name := lastSegment(sel.ModulePath)
+	return fmt.Sprintf(`builds:
+  - id: %s
+    main: ./
+    env:
+      - CGO_ENABLED=0
+    goos:
+      - linux
+      - darwin
+    goarch:
+      - amd64
+      - arm64
+
+archives:
+  - format: tar.gz
+
+checksum:
+  name_template: "checksums.txt"
+`, name)
+}
+
+func lastSegment(modulePath string) string {
+	parts := strings.Split(modulePath, "/")
+	return parts[len(parts)-1]
+}
@@ -0,0 +1,338 @@
+// Package generate renders a working project from a set of options
+// selections: a go.mod with only the chosen require lines uncommented, a
+// main.go wiring the chosen framework to the chosen logger, env files, and
+// a starter internal/ layout.
+package generate
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/bazel"
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/cicd"
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/makefile"
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/options"
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/workspace"
+)
+
+// Project writes every generated file for sel into dir, creating
+// directories as needed.
+func Project(dir string, sel options.Selections) error {
+	files := map[string]string{
+		"go.mod":                      goMod(sel),
+		"main.go":                     mainGo(sel),
+		".env":                        envFile(sel),
+		".env.example":                envFile(sel),
+		"internal/config/config.go":   configGo(sel),
+		"internal/logger/logger.go":   loggerGo(sel),
+		"internal/handler/handler.go": handlerGo(sel),
+		"Makefile":                    makefile.Render(sel),
+	}
+
+	if sel.Private {
+		files["go.work"] = workspace.GoWork(sel.ModulePath, sel.Siblings)
+		files["tools.go"] = workspace.ToolsGo()
+	}
+
+	if sel.CI {
+		files[".github/workflows/ci.yml"] = cicd.Workflow(sel)
+		files["Dockerfile"] = cicd.Dockerfile(sel)
+		files[".goreleaser.yml"] = cicd.Goreleaser(sel)
+	}
+
+	if sel.Bazel {
+		for rel, content := range bazel.BuildFiles(sel) {
+			files[rel] = content
+		}
+		files["bazel/go_repositories.bzl"] = bazel.GoRepositories(sel)
+	}
+
+	for rel, content := range files {
+		path := filepath.Join(dir, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return fmt.Errorf("generate: %s: %w", rel, err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			return fmt.Errorf("generate: %s: %w", rel, err)
+		}
+	}
+	return nil
+}
+
+// Stage runs `git add -A` in dir, matching the makenew-style bootstrap
+// flow of staging the generated project in one step.
+func Stage(dir string) error {
+	cmd := exec.Command("git", "add", "-A")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("generate: git add -A: %w: %s", err, out)
+	}
+	return nil
+}
+
+func goMod(sel options.Selections) string {
+	var requires []string
+	for _, cat := range options.Menu {
+		opt, ok := sel.Choices[cat.Name]
+		if !ok || opt.Import == "" {
+			continue
+		}
+		requires = append(requires, fmt.Sprintf("\t%s %s", opt.Import, opt.Version))
+	}
+	if db, ok := sel.Choices["Database"]; ok && db.Name == "gorm" {
+		if driver, ok := options.FindGormDriver(sel.GormDriverName); ok {
+			requires = append(requires, fmt.Sprintf("\t%s %s", driver.Import, driver.Version))
+		}
+	}
+	if sel.Private {
+		requires = append(requires, fmt.Sprintf("\t%s %s", workspace.ToolsModule, workspace.ToolsVersion))
+	}
+	sort.Strings(requires)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "module %s\n\ngo 1.22\n", sel.ModulePath)
+	if len(requires) > 0 {
+		b.WriteString("\nrequire (\n")
+		b.WriteString(strings.Join(requires, "\n"))
+		b.WriteString("\n)\n")
+	}
+	if sel.Private && len(sel.Siblings) > 0 {
+		b.WriteString("\nreplace (\n")
+		for _, sibling := range sel.Siblings {
+			fmt.Fprintf(&b, "\t%s => ../%s\n", sel.ModulePath+"/"+sibling, sibling)
+		}
+		b.WriteString(")\n")
+	}
+	return b.String()
+}
+
+func mainGo(sel options.Selections) string {
+	fw := sel.Choices["Web Framework"].Name
+	lg := sel.Choices["Logging"].Name
+
+	var handler, serve string
+	switch fw {
+	case "gin":
+		handler = `	r := gin.Default()
+	r.GET("/healthz", func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})`
+		serve = `	if err := r.Run(":8080"); err != nil {
+		log.Fatal(err)
+	}`
+	case "echo":
+		handler = `	e := echo.New()
+	e.GET("/healthz", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})`
+		serve = `	if err := e.Start(":8080"); err != nil {
+		log.Fatal(err)
+	}`
+	case "fiber":
+		handler = `	app := fiber.New()
+	app.Get("/healthz", func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})`
+		serve = `	if err := app.Listen(":8080"); err != nil {
+		log.Fatal(err)
+	}`
+	case "chi":
+		handler = `	r := chi.NewRouter()
+	r.Get("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})`
+		serve = `	if err := http.ListenAndServe(":8080", r); err != nil {
+		log.Fatal(err)
+	}`
+	}
+
+	var logSetup string
+	switch lg {
+	case "zap":
+		logSetup = `	zlog, _ := zap.NewProduction()
+	defer zlog.Sync()
+	zlog.Info("starting server", zap.String("addr", ":8080"))`
+	case "zerolog":
+		logSetup = `	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	zlog.Info().Str("addr", ":8080").Msg("starting server")`
+	default:
+		logSetup = `	logger := slog.Default()
+	logger.Info("starting server", "addr", ":8080")`
+	}
+
+	return fmt.Sprintf(`%s
+
+// main starts the server, or — when invoked as "app -healthcheck" — makes
+// a single request to the server's own health endpoint and exits 0/1,
+// which is what the Dockerfile's HEALTHCHECK runs against this binary
+// instead of needing curl/wget in the (distroless) final image.
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "-healthcheck" {
+		healthCheck()
+		return
+	}
+
+%s
+
+%s
+%s
+}
+
+func healthCheck() {
+	resp, err := http.Get("http://localhost:8080%s")
+	if err != nil {
+		os.Exit(1)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		os.Exit(1)
+	}
+}
+`, mainGoImports(fw, lg), logSetup, handler, serve, healthPath)
+}
+
+const healthPath = "/healthz"
+
+func mainGoImports(fw, lg string) string {
+	imports := []string{`"log"`, `"net/http"`, `"os"`}
+	switch fw {
+	case "gin":
+		imports = append(imports, `"github.com/gin-gonic/gin"`)
+	case "echo":
+		imports = append(imports, `"github.com/labstack/echo/v4"`)
+	case "fiber":
+		imports = append(imports, `"github.com/gofiber/fiber/v2"`)
+	case "chi":
+		imports = append(imports, `"github.com/go-chi/chi/v5"`)
+	}
+	switch lg {
+	case "zap":
+		imports = append(imports, `"go.uber.org/zap"`)
+	case "zerolog":
+		imports = append(imports, `zlog "github.com/rs/zerolog/log"`, `"github.com/rs/zerolog"`)
+	default:
+		imports = append(imports, `"log/slog"`)
+	}
+	return "package main\n\nimport (\n\t" + strings.Join(imports, "\n\t") + "\n)"
+}
+
+func envFile(sel options.Selections) string {
+	return "APP_ENV=development\nAPP_PORT=8080\n"
+}
+
+func configGo(sel options.Selections) string {
+	cfg := sel.Choices["Configuration"].Name
+	switch cfg {
+	case "viper":
+		return `package config
+
+import "github.com/spf13/viper"
+
+// Load reads configuration from the environment and any .env file found
+// in the working directory.
+func Load() (*viper.Viper, error) {
+	v := viper.New()
+	v.AutomaticEnv()
+	v.SetConfigFile(".env")
+	_ = v.ReadInConfig()
+	return v, nil
+}
+`
+	case "godotenv":
+		return `package config
+
+import (
+	"os"
+
+	"github.com/joho/godotenv"
+)
+
+// Load reads .env into the process environment and returns a lookup
+// helper over os.Getenv.
+func Load() (func(key, fallback string) string, error) {
+	if err := godotenv.Load(); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	return func(key, fallback string) string {
+		if v, ok := os.LookupEnv(key); ok {
+			return v
+		}
+		return fallback
+	}, nil
+}
+`
+	default: // envconfig
+		return `package config
+
+import "github.com/kelseyhightower/envconfig"
+
+// Config holds the process configuration, populated from the environment
+// by envconfig.Process.
+type Config struct {
+	Env  string ` + "`envconfig:\"APP_ENV\" default:\"development\"`" + `
+	Port int    ` + "`envconfig:\"APP_PORT\" default:\"8080\"`" + `
+}
+
+// Load populates a Config from the environment.
+func Load() (*Config, error) {
+	var c Config
+	if err := envconfig.Process("", &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+`
+	}
+}
+
+func loggerGo(sel options.Selections) string {
+	switch sel.Choices["Logging"].Name {
+	case "zap":
+		return `package logger
+
+import "go.uber.org/zap"
+
+// New returns a production zap logger.
+func New() (*zap.Logger, error) {
+	return zap.NewProduction()
+}
+`
+	case "zerolog":
+		return `package logger
+
+import (
+	"os"
+
+	"github.com/rs/zerolog"
+)
+
+// New returns a zerolog logger writing to stdout.
+func New() zerolog.Logger {
+	return zerolog.New(os.Stdout).With().Timestamp().Logger()
+}
+`
+	default:
+		return `package logger
+
+import "log/slog"
+
+// New returns the default slog logger.
+func New() *slog.Logger {
+	return slog.Default()
+}
+`
+	}
+}
+
+func handlerGo(sel options.Selections) string {
+	return `package handler
+
+// Health is a placeholder for the project's HTTP handlers; wire it up in
+// main.go with the chosen framework's router.
+type Health struct{}
+`
+}
@@ -0,0 +1,46 @@
+// Package lockfile reads and writes TEMPLATE_VERSIONS.json, the
+// module -> {version, checksum} record of what "scaffold refresh" last
+// resolved each template candidate to.
+package lockfile
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// Entry is the resolved version and checksum for one module.
+type Entry struct {
+	Version  string `json:"version"`
+	Checksum string `json:"checksum"`
+}
+
+// Lockfile maps module import path to its resolved Entry.
+type Lockfile map[string]Entry
+
+// Load reads a Lockfile from path. A missing file is not an error; it
+// returns an empty Lockfile, so "scaffold diff" run before any "scaffold
+// refresh" reports every candidate as having no lockfile entry.
+func Load(path string) (Lockfile, error) {
+	raw, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Lockfile{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var lock Lockfile
+	if err := json.Unmarshal(raw, &lock); err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
+
+// Save writes lock to path as indented JSON.
+func Save(path string, lock Lockfile) error {
+	raw, err := json.MarshalIndent(lock, "", "  ")
+	if err != nil {
+		return err
+	}
+	raw = append(raw, '\n')
+	return os.WriteFile(path, raw, 0o644)
+}
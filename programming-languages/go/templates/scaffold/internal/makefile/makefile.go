@@ -0,0 +1,56 @@
+// Package makefile renders the generated project's Makefile, assembling
+// targets from whichever optional features (private-module preset,
+// CI/Docker artifacts) the user selected.
+package makefile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/options"
+	"github.com/miles990/claude-software-skills/programming-languages/go/templates/scaffold/internal/privatemod"
+)
+
+// Render builds the Makefile content for sel. `lint`, `test`, and `build`
+// are always present; `docker` and `setup` are added when CI artifacts or
+// the private-module preset, respectively, are selected.
+func Render(sel options.Selections) string {
+	var phony, body []string
+
+	phony = append(phony, "lint", "test", "build")
+	body = append(body,
+		"lint:\n\tgolangci-lint run",
+		"test:\n\tgo test ./...",
+		"build:\n\tgo build ./...",
+	)
+
+	if sel.CI {
+		name := lastSegment(sel.ModulePath)
+		phony = append(phony, "docker")
+		body = append(body, fmt.Sprintf("docker:\n\tdocker build -t %s .", name))
+	}
+
+	if sel.Private {
+		phony = append(phony, "setup")
+		body = append(body, setupTarget(sel.PrivatePrefixes))
+	}
+
+	return fmt.Sprintf(".PHONY: %s\n\n%s\n", strings.Join(phony, " "), strings.Join(body, "\n\n"))
+}
+
+func setupTarget(privatePrefixes []string) string {
+	joined := strings.Join(privatePrefixes, ",")
+	var b strings.Builder
+	b.WriteString("setup:\n")
+	fmt.Fprintf(&b, "\tgo env -w GOPRIVATE=%q\n", joined)
+	fmt.Fprintf(&b, "\tgo env -w GONOSUMDB=%q\n", joined)
+	for _, prefix := range privatePrefixes {
+		fmt.Fprintf(&b, "\t%s\n", privatemod.GitInsteadOfLine(prefix))
+	}
+	return b.String()
+}
+
+func lastSegment(modulePath string) string {
+	parts := strings.Split(modulePath, "/")
+	return parts[len(parts)-1]
+}
@@ -0,0 +1,142 @@
+// Package options describes the menu of choices the scaffold tool offers
+// for each slot in the go.mod template (web framework, DB driver, config
+// loader, logger, and the optional packs), and the concrete selections a
+// user makes from that menu.
+package options
+
+// Option is a single pickable dependency: the module path and pinned
+// version that will be uncommented in the generated go.mod.
+type Option struct {
+	Name    string // human-readable label shown in the prompt
+	Import  string // module import path
+	Version string // pinned version, as it appears in the template
+}
+
+// Category is a named, ordered list of mutually exclusive (or optional)
+// Options, mirroring one commented block in the go.mod template.
+type Category struct {
+	Name     string
+	Options  []Option
+	Required bool // if false, the user may skip this category entirely
+}
+
+// Menu is the full set of categories offered by the scaffold tool, in the
+// same order they appear in templates/go.mod.
+var Menu = []Category{
+	{
+		Name:     "Web Framework",
+		Required: true,
+		Options: []Option{
+			{Name: "gin", Import: "github.com/gin-gonic/gin", Version: "v1.9.1"},
+			{Name: "echo", Import: "github.com/labstack/echo/v4", Version: "v4.11.4"},
+			{Name: "fiber", Import: "github.com/gofiber/fiber/v2", Version: "v2.52.0"},
+			{Name: "chi", Import: "github.com/go-chi/chi/v5", Version: "v5.0.11"},
+		},
+	},
+	{
+		Name:     "Database",
+		Required: true,
+		Options: []Option{
+			{Name: "pgx", Import: "github.com/jackc/pgx/v5", Version: "v5.5.2"},
+			{Name: "mysql", Import: "github.com/go-sql-driver/mysql", Version: "v1.7.1"},
+			{Name: "gorm", Import: "gorm.io/gorm", Version: "v1.25.6"},
+		},
+	},
+	{
+		Name:     "Configuration",
+		Required: true,
+		Options: []Option{
+			{Name: "viper", Import: "github.com/spf13/viper", Version: "v1.18.2"},
+			{Name: "godotenv", Import: "github.com/joho/godotenv", Version: "v1.5.1"},
+			{Name: "envconfig", Import: "github.com/kelseyhightower/envconfig", Version: "v1.4.0"},
+		},
+	},
+	{
+		Name:     "Logging",
+		Required: true,
+		Options: []Option{
+			{Name: "zap", Import: "go.uber.org/zap", Version: "v1.26.0"},
+			{Name: "zerolog", Import: "github.com/rs/zerolog", Version: "v1.31.0"},
+			{Name: "slog", Import: "", Version: ""}, // stdlib, no require line
+		},
+	},
+	{
+		Name:     "Validation",
+		Required: false,
+		Options: []Option{
+			{Name: "validator", Import: "github.com/go-playground/validator/v10", Version: "v10.17.0"},
+		},
+	},
+	{
+		Name:     "Authentication",
+		Required: false,
+		Options: []Option{
+			{Name: "jwt", Import: "github.com/golang-jwt/jwt/v5", Version: "v5.2.0"},
+			{Name: "oauth2", Import: "golang.org/x/oauth2", Version: "v0.16.0"},
+		},
+	},
+	{
+		Name:     "Testing",
+		Required: false,
+		Options: []Option{
+			{Name: "testify", Import: "github.com/stretchr/testify", Version: "v1.8.4"},
+			{Name: "mock", Import: "github.com/golang/mock", Version: "v1.6.0"},
+		},
+	},
+}
+
+// GormDrivers lists the SQL dialects gorm can pair with, keyed by the
+// name shown in the "gorm driver" prompt asked when the Database choice
+// is "gorm" (since gorm alone isn't a complete, buildable choice — it
+// needs an underlying dialect package).
+var GormDrivers = []Option{
+	{Name: "postgres", Import: "gorm.io/driver/postgres", Version: "v1.5.4"},
+	{Name: "mysql", Import: "gorm.io/driver/mysql", Version: "v1.5.4"},
+}
+
+// FindGormDriver returns the GormDrivers entry with the given name.
+func FindGormDriver(name string) (Option, bool) {
+	for _, o := range GormDrivers {
+		if o.Name == name {
+			return o, true
+		}
+	}
+	return Option{}, false
+}
+
+// Selections holds one chosen Option per category, keyed by Category.Name,
+// plus the free-form module path the user wants for the generated project.
+type Selections struct {
+	ModulePath string
+	Choices    map[string]Option
+
+	// GormDriverName is the chosen entry in GormDrivers, asked only when
+	// Choices["Database"] is "gorm".
+	GormDriverName string
+
+	// Private, when set, turns on the private-module preset: a go.work,
+	// a tools.go, and a Makefile `setup` target covering PrivatePrefixes
+	// and Siblings are emitted alongside the usual files.
+	Private         bool
+	PrivatePrefixes []string // e.g. "git.mycorp.com/team"
+	Siblings        []string // sibling module directories under ../
+
+	// CI, when set, emits a GitHub Actions workflow, a Dockerfile, a
+	// .goreleaser.yml, and adds a `docker` target to the Makefile.
+	CI bool
+
+	// Bazel, when set, emits BUILD.bazel files for the generated packages
+	// and a bazel/go_repositories.bzl macro for the chosen dependencies.
+	Bazel bool
+}
+
+// Find returns the Option in the named category matching name, or false if
+// there is no such option.
+func (c Category) Find(name string) (Option, bool) {
+	for _, o := range c.Options {
+		if o.Name == name {
+			return o, true
+		}
+	}
+	return Option{}, false
+}
@@ -0,0 +1,72 @@
+// Package privatemod rewrites a generated go.mod to add replace
+// directives for internal module prefixes, and renders the shell script
+// that installs the matching git config — the two things a team needs so
+// `go get` on a private module prefix doesn't fail with "Repository owner
+// does not exist" against the public proxy.
+package privatemod
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// AddReplaces appends a replace block for each prefix to the go.mod file
+// at path, pointing it at a sibling checkout (../<last path segment>).
+// Teams that vendor internal modules elsewhere can edit the generated
+// paths afterward; this only saves typing the boilerplate.
+func AddReplaces(path string, prefixes []string) error {
+	existing, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("privatemod: read %s: %w", path, err)
+	}
+
+	var b strings.Builder
+	b.Write(existing)
+	if len(existing) > 0 && existing[len(existing)-1] != '\n' {
+		b.WriteByte('\n')
+	}
+	b.WriteString("\nreplace (\n")
+	for _, prefix := range prefixes {
+		b.WriteString(replaceLine(prefix))
+	}
+	b.WriteString(")\n")
+
+	return os.WriteFile(path, []byte(b.String()), 0o644)
+}
+
+func replaceLine(prefix string) string {
+	segments := strings.Split(strings.TrimRight(prefix, "/"), "/")
+	local := segments[len(segments)-1]
+	return fmt.Sprintf("\t%s => ../%s\n", prefix, local)
+}
+
+// InstallScript renders a shell script that configures GOPRIVATE,
+// GONOSUMDB, and a git insteadOf rewrite for each prefix, so contributors
+// can fetch the internal modules over SSH instead of the public proxy.
+func InstallScript(prefixes []string) string {
+	joined := strings.Join(prefixes, ",")
+	var b strings.Builder
+	b.WriteString("#!/usr/bin/env bash\nset -euo pipefail\n\n")
+	fmt.Fprintf(&b, "go env -w GOPRIVATE=%q\n", joined)
+	fmt.Fprintf(&b, "go env -w GONOSUMDB=%q\n", joined)
+	for _, prefix := range prefixes {
+		b.WriteString(GitInsteadOfLine(prefix))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// GitInsteadOfLine renders the `git config --global url.<ssh>.insteadOf
+// <https>` line that rewrites fetches of prefix to go over SSH.
+//
+// prefix may contain a subpath (e.g. "git.mycorp.com/team"), so this uses
+// the unambiguous ssh:// URL form rather than git's SCP-like shorthand
+// (git@host:path): the SCP form only parses as a remote when there's no
+// slash before the first colon, so a multi-segment prefix like
+// "git@git.mycorp.com/team:repo.git" is parsed as a local path instead of
+// an SSH remote, and the rewrite silently never fires.
+func GitInsteadOfLine(prefix string) string {
+	prefix = strings.TrimRight(prefix, "/")
+	return fmt.Sprintf("git config --global url.\"ssh://git@%s/\".insteadOf \"https://%s/\"", prefix, prefix)
+}
@@ -0,0 +1,76 @@
+// Package prompt implements the small set of interactive prompts the
+// scaffold CLI needs: choosing one option from a numbered list, and
+// optionally skipping a choice.
+package prompt
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Prompter asks the user questions and reads their answers from in,
+// writing the questions to out.
+type Prompter struct {
+	in  *bufio.Scanner
+	out io.Writer
+}
+
+// New returns a Prompter reading from in and writing prompts to out.
+func New(in io.Reader, out io.Writer) *Prompter {
+	return &Prompter{in: bufio.NewScanner(in), out: out}
+}
+
+// Choose lists names (one per line, 1-indexed) under label and returns the
+// one the user picks. If optional is true, an empty answer returns "" with
+// no error, meaning "skip this category".
+func (p *Prompter) Choose(label string, names []string, optional bool) (string, error) {
+	fmt.Fprintf(p.out, "%s:\n", label)
+	for i, name := range names {
+		fmt.Fprintf(p.out, "  %d) %s\n", i+1, name)
+	}
+	if optional {
+		fmt.Fprint(p.out, "  Enter to skip: ")
+	} else {
+		fmt.Fprint(p.out, "Choice: ")
+	}
+
+	if !p.in.Scan() {
+		if err := p.in.Err(); err != nil {
+			return "", err
+		}
+		return "", io.EOF
+	}
+	answer := strings.TrimSpace(p.in.Text())
+	if answer == "" {
+		if optional {
+			return "", nil
+		}
+		return "", fmt.Errorf("%s: a choice is required", label)
+	}
+
+	n, err := strconv.Atoi(answer)
+	if err != nil || n < 1 || n > len(names) {
+		return "", fmt.Errorf("%s: %q is not a valid choice", label, answer)
+	}
+	return names[n-1], nil
+}
+
+// Ask asks a free-form question and returns the trimmed answer, or
+// defaultValue if the user enters nothing.
+func (p *Prompter) Ask(label, defaultValue string) (string, error) {
+	fmt.Fprintf(p.out, "%s [%s]: ", label, defaultValue)
+	if !p.in.Scan() {
+		if err := p.in.Err(); err != nil {
+			return "", err
+		}
+		return defaultValue, nil
+	}
+	answer := strings.TrimSpace(p.in.Text())
+	if answer == "" {
+		return defaultValue, nil
+	}
+	return answer, nil
+}
@@ -0,0 +1,188 @@
+// Package proxy queries the Go module proxy for the latest version of a
+// module and the checksum database for its verified hash, the same two
+// lookups `go get` itself performs, so the refresh tool can update the
+// template's pinned version comments without hardcoding a registry
+// client.
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	defaultProxy = "https://proxy.golang.org"
+	defaultSumDB = "sum.golang.org"
+)
+
+// Client looks up module versions and checksums, using GOPROXY, GOSUMDB,
+// GONOSUMDB, and GOPRIVATE if set.
+type Client struct {
+	HTTP *http.Client
+	Base string // module proxy base URL; defaults to GOPROXY or proxy.golang.org
+
+	sumDB        string   // checksum database host, or "" if disabled (GOSUMDB=off)
+	noSumPattern []string // GONOSUMDB/GOPRIVATE glob patterns to skip the sumdb for
+}
+
+// NewClient returns a Client reading GOPROXY/GOSUMDB/GONOSUMDB/GOPRIVATE
+// from the environment. It errors if GOPROXY resolves to "off" or
+// "direct", since neither names an HTTP proxy this client can query.
+func NewClient() (*Client, error) {
+	base := os.Getenv("GOPROXY")
+	if base == "" {
+		base = defaultProxy
+	}
+	// GOPROXY may be a comma/pipe separated fallback list; only the first
+	// entry is used here, matching the common single-proxy case.
+	if i := strings.IndexAny(base, ",|"); i >= 0 {
+		base = base[:i]
+	}
+	if base == "off" || base == "direct" {
+		return nil, fmt.Errorf("proxy: GOPROXY=%s has no module proxy to query", base)
+	}
+
+	sumDB := os.Getenv("GOSUMDB")
+	if sumDB == "" {
+		sumDB = defaultSumDB
+	}
+	if sumDB == "off" {
+		sumDB = ""
+	}
+
+	var noSum []string
+	noSum = append(noSum, splitPatterns(os.Getenv("GONOSUMDB"))...)
+	noSum = append(noSum, splitPatterns(os.Getenv("GOPRIVATE"))...) // GOPRIVATE implies GONOSUMDB
+
+	return &Client{
+		HTTP:         &http.Client{Timeout: 10 * time.Second},
+		Base:         base,
+		sumDB:        sumDB,
+		noSumPattern: noSum,
+	}, nil
+}
+
+func splitPatterns(s string) []string {
+	var out []string
+	for _, p := range strings.Split(s, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// skipSumDB reports whether the sumdb lookup for module should be skipped,
+// either because GOSUMDB=off disabled it entirely or because module
+// matches a GONOSUMDB/GOPRIVATE pattern. Patterns match like `go env`
+// describes GOPRIVATE: either the module path itself, a path prefix
+// ("git.mycorp.com/team" also matches "git.mycorp.com/team/sub"), or a
+// glob over path elements.
+func (c *Client) skipSumDB(module string) bool {
+	if c.sumDB == "" {
+		return true
+	}
+	for _, pattern := range c.noSumPattern {
+		if module == pattern || strings.HasPrefix(module, pattern+"/") {
+			return true
+		}
+		if ok, _ := path.Match(pattern, module); ok {
+			return true
+		}
+	}
+	return false
+}
+
+type latestInfo struct {
+	Version string
+	Time    time.Time
+}
+
+// Latest returns the latest version of module known to the proxy.
+func (c *Client) Latest(module string) (string, error) {
+	escaped, err := escapePath(module)
+	if err != nil {
+		return "", err
+	}
+	url := fmt.Sprintf("%s/%s/@latest", c.Base, escaped)
+
+	resp, err := c.HTTP.Get(url)
+	if err != nil {
+		return "", fmt.Errorf("proxy: %s: %w", module, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("proxy: %s: %s: %s", module, resp.Status, body)
+	}
+
+	var info latestInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return "", fmt.Errorf("proxy: %s: decode: %w", module, err)
+	}
+	return info.Version, nil
+}
+
+// Sum returns the h1: checksum the Go checksum database recorded for
+// module@version, the same value that would appear in go.sum. It returns
+// "" without error when the sumdb lookup is disabled for module (GOSUMDB
+// off, or module matches GONOSUMDB/GOPRIVATE) — the caller then records
+// no checksum rather than forcing one from a sumdb the user opted out of.
+func (c *Client) Sum(module, version string) (string, error) {
+	if c.skipSumDB(module) {
+		return "", nil
+	}
+
+	escaped, err := escapePath(module)
+	if err != nil {
+		return "", err
+	}
+	url := fmt.Sprintf("https://%s/lookup/%s@%s", c.sumDB, escaped, version)
+
+	resp, err := c.HTTP.Get(url)
+	if err != nil {
+		return "", fmt.Errorf("sumdb: %s@%s: %w", module, version, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("sumdb: %s@%s: %s: %s", module, version, resp.Status, body)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(body), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 3 && fields[0] == module && fields[1] == version {
+			return fields[2], nil
+		}
+	}
+	return "", fmt.Errorf("sumdb: %s@%s: no h1 hash in response", module, version)
+}
+
+// escapePath applies the module proxy's "!" escaping for uppercase
+// letters (required because module paths are case sensitive but some
+// filesystems and proxies are not).
+func escapePath(module string) (string, error) {
+	var b strings.Builder
+	for _, r := range module {
+		if r >= 'A' && r <= 'Z' {
+			b.WriteByte('!')
+			b.WriteRune(r - 'A' + 'a')
+			continue
+		}
+		if r == '!' {
+			return "", fmt.Errorf("proxy: invalid module path %q", module)
+		}
+		b.WriteRune(r)
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,82 @@
+// Package semver does just enough semantic version parsing to classify a
+// version bump as major, minor, or patch, for the "diff" subcommand's
+// renovate-style report.
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Bump classifies the change between two semver strings.
+type Bump int
+
+const (
+	None Bump = iota
+	Patch
+	Minor
+	Major
+	Unknown // either version didn't parse as semver
+)
+
+func (b Bump) String() string {
+	switch b {
+	case None:
+		return "none"
+	case Patch:
+		return "patch"
+	case Minor:
+		return "minor"
+	case Major:
+		return "major"
+	default:
+		return "unknown"
+	}
+}
+
+// Compare classifies the bump from `from` to `to`, e.g. Compare("v1.2.3",
+// "v1.3.0") is Minor.
+func Compare(from, to string) Bump {
+	if from == to {
+		return None
+	}
+	fMaj, fMin, fPatch, ok1 := parse(from)
+	tMaj, tMin, tPatch, ok2 := parse(to)
+	if !ok1 || !ok2 {
+		return Unknown
+	}
+	switch {
+	case fMaj != tMaj:
+		return Major
+	case fMin != tMin:
+		return Minor
+	case fPatch != tPatch:
+		return Patch
+	default:
+		return None
+	}
+}
+
+func parse(v string) (major, minor, patch int, ok bool) {
+	v = strings.TrimPrefix(v, "v")
+	// Drop any pre-release/build metadata suffix (-rc1, +meta) for the
+	// purposes of major/minor/patch classification.
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	parts := strings.SplitN(v, ".", 3)
+	if len(parts) != 3 {
+		return 0, 0, 0, false
+	}
+	var err error
+	if major, err = strconv.Atoi(parts[0]); err != nil {
+		return 0, 0, 0, false
+	}
+	if minor, err = strconv.Atoi(parts[1]); err != nil {
+		return 0, 0, 0, false
+	}
+	if patch, err = strconv.Atoi(parts[2]); err != nil {
+		return 0, 0, 0, false
+	}
+	return major, minor, patch, true
+}
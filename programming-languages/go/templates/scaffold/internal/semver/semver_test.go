@@ -0,0 +1,52 @@
+package semver
+
+import "testing"
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		from, to string
+		want     Bump
+	}{
+		{"v1.2.3", "v1.2.3", None},
+		{"v1.2.3", "v1.2.4", Patch},
+		{"v1.2.3", "v1.3.0", Minor},
+		{"v1.2.3", "v2.0.0", Major},
+		{"v1.2.3", "v1.2.2", Patch},
+		{"v1.2.3-rc1", "v1.2.4", Patch},
+		{"v1.2.3", "not-a-version", Unknown},
+		{"garbage", "v1.2.3", Unknown},
+	}
+	for _, c := range cases {
+		if got := Compare(c.from, c.to); got != c.want {
+			t.Errorf("Compare(%q, %q) = %s, want %s", c.from, c.to, got, c.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		version             string
+		major, minor, patch int
+		ok                  bool
+	}{
+		{"v1.2.3", 1, 2, 3, true},
+		{"1.2.3", 1, 2, 3, true},
+		{"v1.2.3-rc1", 1, 2, 3, true},
+		{"v1.2.3+meta", 1, 2, 3, true},
+		{"v1.2", 0, 0, 0, false},
+		{"vX.2.3", 0, 0, 0, false},
+	}
+	for _, c := range cases {
+		major, minor, patch, ok := parse(c.version)
+		if ok != c.ok {
+			t.Errorf("parse(%q) ok = %v, want %v", c.version, ok, c.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if major != c.major || minor != c.minor || patch != c.patch {
+			t.Errorf("parse(%q) = %d,%d,%d, want %d,%d,%d", c.version, major, minor, patch, c.major, c.minor, c.patch)
+		}
+	}
+}
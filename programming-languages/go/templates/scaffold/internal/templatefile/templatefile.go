@@ -0,0 +1,90 @@
+// Package templatefile parses and rewrites the commented dependency menu
+// in templates/go.mod: each candidate under `require (...)` is commented
+// out as `// <module> <version>`, and this package lets the refresh tool
+// update those version comments in place without disturbing anything
+// else in the file.
+package templatefile
+
+import (
+	"os"
+	"regexp"
+	"strings"
+)
+
+// depLine matches a single commented dependency candidate, e.g.
+// "\t// github.com/gin-gonic/gin v1.9.1".
+var depLine = regexp.MustCompile(`^(\s*// )(\S+) (v\S+)$`)
+
+// Dependency is one candidate dependency found in the template.
+type Dependency struct {
+	Module  string
+	Version string
+
+	line int // index into Template.lines, for in-place rewrites
+}
+
+// Template is the parsed form of a go.mod template: its raw lines, plus
+// the dependency candidates found among them.
+type Template struct {
+	lines []string
+	deps  []Dependency
+}
+
+// Parse reads the template at path and finds its commented dependency
+// candidates.
+func Parse(path string) (*Template, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Template{lines: strings.Split(string(raw), "\n")}
+	for i, line := range t.lines {
+		m := depLine.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		t.deps = append(t.deps, Dependency{Module: m[2], Version: m[3], line: i})
+	}
+	return t, nil
+}
+
+// Dependencies returns every candidate dependency found in the template.
+func (t *Template) Dependencies() []Dependency {
+	return t.deps
+}
+
+// VersionsByModule reads the template at path and returns the pinned
+// version currently commented in for each candidate module. It lets
+// `scaffold new` pick up whatever `scaffold refresh` last wrote, instead
+// of a version baked into the option menu at compile time.
+func VersionsByModule(path string) (map[string]string, error) {
+	t, err := Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	versions := make(map[string]string, len(t.deps))
+	for _, d := range t.deps {
+		versions[d.Module] = d.Version
+	}
+	return versions, nil
+}
+
+// SetVersion rewrites the version comment for module to version, leaving
+// the rest of the line (indentation, module path) untouched. It is a
+// no-op if module isn't a candidate in the template.
+func (t *Template) SetVersion(module, version string) {
+	for i, d := range t.deps {
+		if d.Module != module {
+			continue
+		}
+		m := depLine.FindStringSubmatch(t.lines[d.line])
+		t.lines[d.line] = m[1] + m[2] + " " + version
+		t.deps[i].Version = version
+	}
+}
+
+// Write saves the (possibly rewritten) template back to path.
+func (t *Template) Write(path string) error {
+	return os.WriteFile(path, []byte(strings.Join(t.lines, "\n")), 0o644)
+}
@@ -0,0 +1,90 @@
+package templatefile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const sampleMod = `module example.com/template
+
+go 1.22
+
+require (
+	// github.com/gin-gonic/gin v1.9.1
+	// github.com/labstack/echo/v4 v4.11.4
+	github.com/spf13/viper v1.18.2
+)
+`
+
+func writeSample(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "go.mod")
+	if err := os.WriteFile(path, []byte(sampleMod), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestParseDependencies(t *testing.T) {
+	tmpl, err := Parse(writeSample(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	deps := tmpl.Dependencies()
+	if len(deps) != 2 {
+		t.Fatalf("Dependencies() returned %d entries, want 2: %+v", len(deps), deps)
+	}
+	want := []Dependency{
+		{Module: "github.com/gin-gonic/gin", Version: "v1.9.1"},
+		{Module: "github.com/labstack/echo/v4", Version: "v4.11.4"},
+	}
+	for i, d := range want {
+		if deps[i].Module != d.Module || deps[i].Version != d.Version {
+			t.Errorf("deps[%d] = %+v, want %+v", i, deps[i], d)
+		}
+	}
+}
+
+func TestVersionsByModule(t *testing.T) {
+	versions, err := VersionsByModule(writeSample(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := versions["github.com/gin-gonic/gin"]; got != "v1.9.1" {
+		t.Errorf("versions[gin] = %q, want v1.9.1", got)
+	}
+	if _, ok := versions["github.com/spf13/viper"]; ok {
+		t.Error("versions should not include the uncommented require line")
+	}
+}
+
+func TestSetVersion(t *testing.T) {
+	path := writeSample(t)
+	tmpl, err := Parse(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl.SetVersion("github.com/gin-gonic/gin", "v1.9.2")
+	tmpl.SetVersion("no/such/module", "v9.9.9") // no-op
+
+	deps := tmpl.Dependencies()
+	if deps[0].Version != "v1.9.2" {
+		t.Errorf("SetVersion did not update Dependencies(): got %q", deps[0].Version)
+	}
+
+	if err := tmpl.Write(path); err != nil {
+		t.Fatal(err)
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reparsed, err := Parse(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := reparsed.Dependencies()[0].Version; v != "v1.9.2" {
+		t.Errorf("after Write+Parse, version = %q, want v1.9.2; file:\n%s", v, raw)
+	}
+}
@@ -0,0 +1,50 @@
+// Package workspace generates the optional go.work and tools.go files for
+// the "private module" preset: a project layout that expects to pull some
+// dependencies from sibling checkouts and/or a private module proxy
+// instead of the public one. The preset's Makefile `setup` target lives in
+// package makefile, which composes it alongside the project's other
+// targets.
+package workspace
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ToolsModule and ToolsVersion are the module path and pinned version ToolsGo's blank
+// import needs in go.mod's require block; generate.goMod adds it whenever
+// the private-module preset is selected.
+const (
+	ToolsModule  = "golang.org/x/tools"
+	ToolsVersion = "v0.18.0"
+)
+
+// GoWork renders a go.work tying the generated project to any sibling
+// modules checked out under ../, so `go build` resolves them without a
+// go.mod replace directive during local development.
+func GoWork(modulePath string, siblings []string) string {
+	var b strings.Builder
+	b.WriteString("go 1.22\n\nuse (\n\t.\n")
+	for _, s := range siblings {
+		fmt.Fprintf(&b, "\t../%s\n", s)
+	}
+	b.WriteString(")\n")
+	return b.String()
+}
+
+// ToolsGo renders a tools.go that pins build-time tool dependencies via
+// blank imports, the standard way to track them in go.mod without them
+// leaking into the build.
+func ToolsGo() string {
+	return `//go:build tools
+
+// Package tools tracks build-time tool dependencies in go.mod so
+// 'go mod tidy' doesn't remove them; see
+// https://github.com/golang/go/wiki/Modules#how-can-i-track-tool-dependencies-for-a-module.
+package tools
+
+import (
+	_ "golang.org/x/tools/cmd/goimports"
+)
+`
+}